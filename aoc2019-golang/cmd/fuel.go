@@ -5,7 +5,6 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
-	"pencethren.org/aoc2019/file"
 	"pencethren.org/aoc2019/fuel"
 )
 
@@ -19,17 +18,30 @@ var fuelCmd = &cobra.Command{
 	Long:  "Calculate the fuel necessary to launch the ship.",
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		moduleMasses, err := file.IntLines(args[0])
+		input, err := os.Open(args[0])
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error loading file: %v\n", err)
 			os.Exit(1)
 		}
+		defer input.Close()
 
-		ship := fuel.NewShip(moduleMasses[:])
+		solver := fuel.Solver{}
+		data, err := solver.Parse(input)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error parsing file: %v\n", err)
+			os.Exit(1)
+		}
+
+		var result string
 		if !part2 {
-			fmt.Printf("Fuel needed: %v\n", ship.Fuel())
+			result, err = solver.Part1(data)
 		} else {
-			fmt.Printf("Fuel needed: %v\n", ship.CompoundFuel())
+			result, err = solver.Part2(data)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
 		}
+		fmt.Printf("Fuel needed: %v\n", result)
 	},
 }
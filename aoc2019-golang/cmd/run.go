@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"pencethren.org/aoc2019/puzzle"
+)
+
+func init() {
+	runCmd.Flags().IntVar(&runDay, "day", 0, "Run only the solver for this day (default: all registered days).")
+	runCmd.Flags().IntVar(&runPart, "part", 0, "Run only this part, 1 or 2 (default: both).")
+	runCmd.Flags().BoolVar(&runVerify, "verify", false, "Check each part's output against inputs/dayNN.expected.")
+	runCmd.Flags().BoolVar(&runBench, "bench", false, "Benchmark each part over --bench-runs repetitions.")
+	runCmd.Flags().IntVar(&runBenchRuns, "bench-runs", 10, "Number of repetitions to use with --bench.")
+	runCmd.Flags().StringVar(&runInputsDir, "inputs", "inputs", "Directory containing dayNN.txt (and dayNN.expected) files.")
+	rootCmd.AddCommand(runCmd)
+}
+
+var (
+	runDay       int
+	runPart      int
+	runVerify    bool
+	runBench     bool
+	runBenchRuns int
+	runInputsDir string
+)
+
+var runCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Run registered puzzle solvers",
+	Long:  "Discover and run registered puzzle.Solvers, optionally verifying their output against known-good answers and benchmarking their running time.",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		opts := puzzle.Options{
+			Day:       runDay,
+			Part:      runPart,
+			InputsDir: runInputsDir,
+			Verify:    runVerify,
+			Bench:     runBench,
+			BenchRuns: runBenchRuns,
+		}
+		if err := puzzle.Run(puzzle.Default, opts, os.Stdout); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	},
+}
@@ -0,0 +1,19 @@
+package file
+
+func Grid[T any](path string, convert func(rune) T) ([][]T, error) {
+	data, err := Read(path)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := Lines(data)
+	grid := make([][]T, len(lines))
+	for i, line := range lines {
+		row := make([]T, 0, len(line))
+		for _, r := range line {
+			row = append(row, convert(r))
+		}
+		grid[i] = row
+	}
+	return grid, nil
+}
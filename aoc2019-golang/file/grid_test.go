@@ -0,0 +1,31 @@
+package file
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGrid(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want [][]rune
+	}{
+		{"square grid", "#.#\n.#.\n#.#", [][]rune{{'#', '.', '#'}, {'.', '#', '.'}, {'#', '.', '#'}}},
+		{"crlf line endings", "##\r\n..\r\n", [][]rune{{'#', '#'}, {'.', '.'}}},
+		{"empty file", "", [][]rune{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeTempFile(t, tt.data)
+			got, err := Grid(path, func(r rune) rune { return r })
+			if err != nil {
+				t.Fatalf("Grid(%q) returned error: %v", tt.data, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Grid(%q) = %v, want %v", tt.data, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package file
+
+func Groups(path string) ([][]string, error) {
+	data, err := Read(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var groups [][]string
+	var current []string
+	for _, line := range Lines(data) {
+		if line == "" {
+			if len(current) > 0 {
+				groups = append(groups, current)
+				current = nil
+			}
+			continue
+		}
+		current = append(current, line)
+	}
+	if len(current) > 0 {
+		groups = append(groups, current)
+	}
+	return groups, nil
+}
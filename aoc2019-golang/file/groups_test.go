@@ -0,0 +1,32 @@
+package file
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGroups(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want [][]string
+	}{
+		{"two groups", "a\nb\n\nc", [][]string{{"a", "b"}, {"c"}}},
+		{"crlf line endings", "a\r\nb\r\n\r\nc\r\n", [][]string{{"a", "b"}, {"c"}}},
+		{"trailing blank lines collapse", "a\n\n\n", [][]string{{"a"}}},
+		{"empty file", "", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeTempFile(t, tt.data)
+			got, err := Groups(path)
+			if err != nil {
+				t.Fatalf("Groups(%q) returned error: %v", tt.data, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Groups(%q) = %v, want %v", tt.data, got, tt.want)
+			}
+		})
+	}
+}
@@ -1,23 +1,56 @@
 package file
 
 import (
-	"io/ioutil"
+	"fmt"
+	"io"
 	"strconv"
 	"strings"
 )
 
-func IntLines(filename string) ([]int, error) {
-	fileBytes, err := ioutil.ReadFile(filename)
+func IntLinesSep(sep string) func(path string) ([]int, error) {
+	return func(path string) ([]int, error) {
+		data, err := Read(path)
+		if err != nil {
+			return nil, err
+		}
+		return parseInts(data, sep)
+	}
+}
+
+func IntCSV(path string) ([]int, error) {
+	return IntLinesSep(",")(path)
+}
+
+func Ints(r io.Reader, sep string) ([]int, error) {
+	data, err := io.ReadAll(r)
 	if err != nil {
 		return nil, err
 	}
+	return parseInts(data, sep)
+}
 
-	lines := strings.Split(strings.TrimSpace(string(fileBytes)), "\n")
-	values := make([]int, len(lines))
-	for i, val := range lines {
-		if values[i], err = strconv.Atoi(val); err != nil {
-			return nil, err
+func parseInts(data []byte, sep string) ([]int, error) {
+	text := strings.TrimSpace(string(data))
+	if text == "" {
+		return []int{}, nil
+	}
+
+	// Error messages read "line N" for newline-separated input and
+	// "field N" for anything else (e.g. CSV), since N only means a file
+	// line number when sep is "\n".
+	unit := "line"
+	if sep != "\n" {
+		unit = "field"
+	}
+
+	fields := strings.Split(text, sep)
+	values := make([]int, len(fields))
+	for i, field := range fields {
+		value, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil {
+			return nil, fmt.Errorf("%s %d: %w", unit, i+1, err)
 		}
+		values[i] = value
 	}
 	return values, nil
 }
@@ -0,0 +1,68 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func writeTempFile(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "input.txt")
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatalf("writing temp file: %v", err)
+	}
+	return path
+}
+
+func TestIntLinesSep(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		want     []int
+	}{
+		{"newline separated", "12\n14\n1969\n100756", []int{12, 14, 1969, 100756}},
+		{"trailing newline", "12\n14\n", []int{12, 14}},
+		{"crlf line endings", "12\r\n14\r\n1969\r\n", []int{12, 14, 1969}},
+		{"trailing whitespace", "12\n14\n   \n", []int{12, 14}},
+		{"empty file", "", []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeTempFile(t, tt.contents)
+			got, err := IntLinesSep("\n")(path)
+			if err != nil {
+				t.Fatalf("IntLinesSep(\"\\n\")(%q) returned error: %v", tt.contents, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("IntLinesSep(\"\\n\")(%q) = %v, want %v", tt.contents, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntLinesSepLineNumberInError(t *testing.T) {
+	path := writeTempFile(t, "12\n14\nnot-a-number\n")
+	_, err := IntLinesSep("\n")(path)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	want := "line 3: "
+	if got := err.Error(); len(got) < len(want) || got[:len(want)] != want {
+		t.Errorf("error = %q, want prefix %q", got, want)
+	}
+}
+
+func TestIntCSV(t *testing.T) {
+	path := writeTempFile(t, "1,0,0,3,99\n")
+	got, err := IntCSV(path)
+	if err != nil {
+		t.Fatalf("IntCSV returned error: %v", err)
+	}
+	want := []int{1, 0, 0, 3, 99}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("IntCSV = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,17 @@
+package file
+
+import "strings"
+
+func Lines(data []byte) []string {
+	text := strings.TrimRight(string(data), "\r\n")
+	if text == "" {
+		return []string{}
+	}
+
+	rawLines := strings.Split(text, "\n")
+	lines := make([]string, len(rawLines))
+	for i, line := range rawLines {
+		lines[i] = strings.TrimSuffix(line, "\r")
+	}
+	return lines
+}
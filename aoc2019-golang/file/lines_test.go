@@ -0,0 +1,29 @@
+package file
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want []string
+	}{
+		{"lf line endings", "a\nb\nc", []string{"a", "b", "c"}},
+		{"crlf line endings", "a\r\nb\r\nc\r\n", []string{"a", "b", "c"}},
+		{"trailing blank lines", "a\nb\n\n\n", []string{"a", "b"}},
+		{"blank line in the middle is kept", "a\n\nb", []string{"a", "", "b"}},
+		{"empty file", "", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Lines([]byte(tt.data))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Lines(%q) = %v, want %v", tt.data, got, tt.want)
+			}
+		})
+	}
+}
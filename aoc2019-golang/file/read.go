@@ -0,0 +1,14 @@
+package file
+
+import "os"
+
+func Read(path string) ([]byte, error) {
+	return os.ReadFile(path)
+}
+
+func Must[T any](value T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
@@ -0,0 +1,21 @@
+package file
+
+import "fmt"
+
+func Records[T any](path string, parseLine func(string) (T, error)) ([]T, error) {
+	data, err := Read(path)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := Lines(data)
+	records := make([]T, len(lines))
+	for i, line := range lines {
+		record, err := parseLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		records[i] = record
+	}
+	return records, nil
+}
@@ -0,0 +1,44 @@
+package file
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestRecords(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want []int
+	}{
+		{"one per line", "1\n2\n3", []int{1, 2, 3}},
+		{"crlf line endings", "1\r\n2\r\n3\r\n", []int{1, 2, 3}},
+		{"empty file", "", []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeTempFile(t, tt.data)
+			got, err := Records(path, strconv.Atoi)
+			if err != nil {
+				t.Fatalf("Records(%q) returned error: %v", tt.data, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Records(%q) = %v, want %v", tt.data, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecordsLineNumberInError(t *testing.T) {
+	path := writeTempFile(t, "1\n2\nnot-a-number\n")
+	_, err := Records(path, strconv.Atoi)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	want := "line 3: "
+	if got := err.Error(); len(got) < len(want) || got[:len(want)] != want {
+		t.Errorf("error = %q, want prefix %q", got, want)
+	}
+}
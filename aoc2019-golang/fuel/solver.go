@@ -0,0 +1,35 @@
+package fuel
+
+import (
+	"io"
+	"strconv"
+
+	"pencethren.org/aoc2019/file"
+	"pencethren.org/aoc2019/puzzle"
+)
+
+func init() {
+	puzzle.Register(1, Solver{})
+}
+
+// Solver adapts the fuel package to the puzzle.Solver interface, so it can
+// be run through `aoc2019 run` instead of its own dedicated command.
+type Solver struct{}
+
+func (Solver) Name() string {
+	return "fuel"
+}
+
+func (Solver) Parse(r io.Reader) (any, error) {
+	return file.Ints(r, "\n")
+}
+
+func (Solver) Part1(data any) (string, error) {
+	ship := NewShip(data.([]int))
+	return strconv.Itoa(ship.Fuel()), nil
+}
+
+func (Solver) Part2(data any) (string, error) {
+	ship := NewShip(data.([]int))
+	return strconv.Itoa(ship.CompoundFuel()), nil
+}
@@ -0,0 +1,176 @@
+package puzzle
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Options controls which days and parts Run executes, and how.
+type Options struct {
+	// Day selects a single day to run; zero means every registered day.
+	Day int
+	// Part selects a single part (1 or 2) to run; zero means both.
+	Part int
+	// InputsDir is the directory dayNN.txt (and dayNN.expected) live in.
+	InputsDir string
+	// Verify reads dayNN.expected and checks Part1/Part2 output against it.
+	Verify bool
+	// Bench runs each requested part BenchRuns times and reports timings.
+	Bench bool
+	// BenchRuns is how many times to repeat each part when Bench is set.
+	BenchRuns int
+}
+
+// Run executes the days selected by opts against registry, writing a
+// human-readable report to out. It returns an error if any verification
+// fails, so callers can use it to set a non-zero exit code.
+func Run(registry *Registry, opts Options, out io.Writer) error {
+	days := registry.Days()
+	if opts.Day != 0 {
+		days = filterDay(days, opts.Day)
+	}
+	if len(days) == 0 {
+		return fmt.Errorf("no registered solver for day %d", opts.Day)
+	}
+
+	var passed, failed int
+	for _, day := range days {
+		solver, _ := registry.Get(day)
+		if err := runDay(day, solver, opts, out, &passed, &failed); err != nil {
+			fmt.Fprintf(out, "day %02d (%s): %v\n", day, solver.Name(), err)
+			failed++
+		}
+	}
+
+	if opts.Verify {
+		fmt.Fprintf(out, "\n%d passed, %d failed\n", passed, failed)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d checks failed", failed, passed+failed)
+	}
+	return nil
+}
+
+func runDay(day int, solver Solver, opts Options, out io.Writer, passed, failed *int) error {
+	inputPath := filepath.Join(opts.InputsDir, fmt.Sprintf("day%02d.txt", day))
+	input, err := os.Open(inputPath)
+	if err != nil {
+		return fmt.Errorf("loading input: %w", err)
+	}
+	defer input.Close()
+
+	data, err := solver.Parse(input)
+	if err != nil {
+		return fmt.Errorf("parsing input: %w", err)
+	}
+
+	var expected []string
+	if opts.Verify {
+		if expected, err = readExpected(opts.InputsDir, day); err != nil {
+			return fmt.Errorf("loading expected output: %w", err)
+		}
+	}
+
+	for _, part := range []int{1, 2} {
+		if opts.Part != 0 && opts.Part != part {
+			continue
+		}
+		runPart(day, solver, part, data, expected, opts, out, passed, failed)
+	}
+	return nil
+}
+
+func runPart(day int, solver Solver, part int, data any, expected []string, opts Options, out io.Writer, passed, failed *int) {
+	runOnce := func() (string, error) {
+		if part == 1 {
+			return solver.Part1(data)
+		}
+		return solver.Part2(data)
+	}
+
+	start := time.Now()
+	result, err := runOnce()
+	elapsed := time.Since(start)
+
+	label := fmt.Sprintf("day %02d part %d (%s)", day, part, solver.Name())
+	if err != nil {
+		fmt.Fprintf(out, "%s: error: %v\n", label, err)
+		if opts.Verify {
+			*failed++
+		}
+		return
+	}
+	fmt.Fprintf(out, "%s: %s (%v)\n", label, result, elapsed)
+
+	if opts.Verify {
+		want := expected[part-1]
+		if want == "" {
+			fmt.Fprintf(out, "%s: SKIP (no expected value)\n", label)
+		} else if result == want {
+			fmt.Fprintf(out, "%s: PASS\n", label)
+			*passed++
+		} else {
+			fmt.Fprintf(out, "%s: FAIL (got %q, want %q)\n", label, result, want)
+			*failed++
+		}
+	}
+
+	if opts.Bench {
+		runBench(label, runOnce, opts.BenchRuns, out)
+	}
+}
+
+func runBench(label string, runOnce func() (string, error), runs int, out io.Writer) {
+	if runs <= 0 {
+		runs = 1
+	}
+	min, max := time.Duration(0), time.Duration(0)
+	var total time.Duration
+	for i := 0; i < runs; i++ {
+		start := time.Now()
+		if _, err := runOnce(); err != nil {
+			fmt.Fprintf(out, "%s: bench run %d: %v\n", label, i, err)
+			return
+		}
+		elapsed := time.Since(start)
+		total += elapsed
+		if i == 0 || elapsed < min {
+			min = elapsed
+		}
+		if i == 0 || elapsed > max {
+			max = elapsed
+		}
+	}
+	mean := total / time.Duration(runs)
+	fmt.Fprintf(out, "%s: bench x%d min=%v mean=%v max=%v\n", label, runs, min, mean, max)
+}
+
+func readExpected(inputsDir string, day int) ([]string, error) {
+	path := filepath.Join(inputsDir, fmt.Sprintf("day%02d.expected", day))
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	lines := make([]string, 2)
+	scanner := bufio.NewScanner(f)
+	for i := 0; i < 2 && scanner.Scan(); i++ {
+		lines[i] = strings.TrimSpace(scanner.Text())
+	}
+	return lines, scanner.Err()
+}
+
+func filterDay(days []int, day int) []int {
+	for _, d := range days {
+		if d == day {
+			return []int{d}
+		}
+	}
+	return nil
+}
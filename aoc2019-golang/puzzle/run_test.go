@@ -0,0 +1,149 @@
+package puzzle
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// fakeSolver parses a single integer and reports it doubled (part 1) and
+// tripled (part 2), which is enough to exercise Run's control flow without
+// depending on a real day.
+type fakeSolver struct{}
+
+func (fakeSolver) Name() string { return "fake" }
+
+func (fakeSolver) Parse(r io.Reader) (any, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return strconv.Atoi(strings.TrimSpace(string(data)))
+}
+
+func (fakeSolver) Part1(data any) (string, error) {
+	return strconv.Itoa(data.(int) * 2), nil
+}
+
+func (fakeSolver) Part2(data any) (string, error) {
+	return strconv.Itoa(data.(int) * 3), nil
+}
+
+func writeInput(t *testing.T, dir string, day int, input, expected string) {
+	t.Helper()
+	txtPath := filepath.Join(dir, fmt.Sprintf("day%02d.txt", day))
+	if err := os.WriteFile(txtPath, []byte(input), 0o644); err != nil {
+		t.Fatalf("writing input: %v", err)
+	}
+	if expected != "" {
+		expectedPath := filepath.Join(dir, fmt.Sprintf("day%02d.expected", day))
+		if err := os.WriteFile(expectedPath, []byte(expected), 0o644); err != nil {
+			t.Fatalf("writing expected: %v", err)
+		}
+	}
+}
+
+func newTestRegistry() *Registry {
+	registry := NewRegistry()
+	registry.Register(1, fakeSolver{})
+	registry.Register(2, fakeSolver{})
+	registry.Register(3, fakeSolver{})
+	return registry
+}
+
+func TestRunVerifyPassFailSkip(t *testing.T) {
+	dir := t.TempDir()
+	writeInput(t, dir, 1, "5", "10\n15") // part1 10 == 10 PASS, part2 15 == 15 PASS
+	writeInput(t, dir, 2, "5", "99\n15") // part1 10 != 99 FAIL, part2 15 == 15 PASS
+	writeInput(t, dir, 3, "5", "\n15")   // part1 SKIP (no expected), part2 PASS
+
+	var out bytes.Buffer
+	err := Run(newTestRegistry(), Options{InputsDir: dir, Verify: true}, &out)
+	if err == nil {
+		t.Fatal("expected an error because of the day 2 part 1 failure")
+	}
+
+	report := out.String()
+	for _, want := range []string{
+		"day 01 part 1 (fake): PASS",
+		"day 01 part 2 (fake): PASS",
+		"day 02 part 1 (fake): FAIL",
+		"day 02 part 2 (fake): PASS",
+		"day 03 part 1 (fake): SKIP",
+		"day 03 part 2 (fake): PASS",
+		"4 passed, 1 failed",
+	} {
+		if !strings.Contains(report, want) {
+			t.Errorf("report missing %q; got:\n%s", want, report)
+		}
+	}
+}
+
+func TestRunDayFilter(t *testing.T) {
+	dir := t.TempDir()
+	writeInput(t, dir, 1, "5", "")
+	writeInput(t, dir, 2, "5", "")
+	writeInput(t, dir, 3, "5", "")
+
+	var out bytes.Buffer
+	if err := Run(newTestRegistry(), Options{Day: 2, InputsDir: dir}, &out); err != nil {
+		t.Fatalf("Run returned error: %v", err)
+	}
+
+	report := out.String()
+	if strings.Contains(report, "day 01") || strings.Contains(report, "day 03") {
+		t.Errorf("report should only cover day 2; got:\n%s", report)
+	}
+	if !strings.Contains(report, "day 02") {
+		t.Errorf("report missing day 02; got:\n%s", report)
+	}
+}
+
+func TestRunPartFilter(t *testing.T) {
+	dir := t.TempDir()
+	writeInput(t, dir, 1, "5", "")
+
+	var out bytes.Buffer
+	if err := Run(newTestRegistry(), Options{Day: 1, Part: 1, InputsDir: dir}, &out); err != nil {
+		t.Fatalf("Run returned error: %v", err)
+	}
+
+	report := out.String()
+	if !strings.Contains(report, "part 1") {
+		t.Errorf("report missing part 1; got:\n%s", report)
+	}
+	if strings.Contains(report, "part 2") {
+		t.Errorf("report should not mention part 2; got:\n%s", report)
+	}
+}
+
+func TestFilterDay(t *testing.T) {
+	days := []int{1, 2, 3}
+
+	if got := filterDay(days, 2); !reflect.DeepEqual(got, []int{2}) {
+		t.Errorf("filterDay(%v, 2) = %v, want [2]", days, got)
+	}
+	if got := filterDay(days, 5); got != nil {
+		t.Errorf("filterDay(%v, 5) = %v, want nil", days, got)
+	}
+}
+
+func TestReadExpected(t *testing.T) {
+	dir := t.TempDir()
+	writeInput(t, dir, 4, "5", "42\n99\n")
+
+	got, err := readExpected(dir, 4)
+	if err != nil {
+		t.Fatalf("readExpected returned error: %v", err)
+	}
+	want := []string{"42", "99"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("readExpected = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,61 @@
+// Package puzzle provides a generic harness for discovering, running,
+// verifying and benchmarking Advent of Code solvers, so that individual
+// days don't need their own bespoke cobra command and input-handling
+// boilerplate.
+package puzzle
+
+import (
+	"io"
+	"sort"
+)
+
+// Solver implements a single day's puzzle. Parse converts the raw puzzle
+// input into whatever representation Part1 and Part2 need; the two parts
+// are run against the same parsed value so parsing only happens once.
+type Solver interface {
+	Name() string
+	Parse(io.Reader) (any, error)
+	Part1(any) (string, error)
+	Part2(any) (string, error)
+}
+
+// Registry maps AoC day numbers to the Solver that handles them.
+type Registry struct {
+	solvers map[int]Solver
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{solvers: make(map[int]Solver)}
+}
+
+// Register associates a Solver with a day number, overwriting any solver
+// previously registered for that day.
+func (r *Registry) Register(day int, solver Solver) {
+	r.solvers[day] = solver
+}
+
+// Get returns the Solver registered for day, if any.
+func (r *Registry) Get(day int) (Solver, bool) {
+	solver, ok := r.solvers[day]
+	return solver, ok
+}
+
+// Days returns the registered day numbers in ascending order.
+func (r *Registry) Days() []int {
+	days := make([]int, 0, len(r.solvers))
+	for day := range r.solvers {
+		days = append(days, day)
+	}
+	sort.Ints(days)
+	return days
+}
+
+// Default is the Registry that day packages register themselves into via
+// init(), in the same spirit as http.DefaultServeMux.
+var Default = NewRegistry()
+
+// Register adds solver to the Default registry under day.
+func Register(day int, solver Solver) {
+	Default.Register(day, solver)
+}